@@ -0,0 +1,78 @@
+package pagination
+
+import (
+	"reflect"
+
+	"github.com/getevo/evo/v2/lib/ptr"
+	"gorm.io/gorm"
+)
+
+// SkipCount toggles whether LoadData runs a COUNT(*) query for offset
+// mode. When skip is true, Records/Pages/Links are left unset and
+// HasMore is computed instead by fetching one extra row past Size and
+// trimming it off the result - useful for infinite-scroll UIs that only
+// need to know whether another page exists.
+func (p *Pagination) SkipCount(skip bool) *Pagination {
+	p.countTotal = !skip
+	return p
+}
+
+// loadWithCount runs the COUNT(*) and the LIMIT/OFFSET Find concurrently,
+// since on large tables the count query often dominates wall time.
+func (p *Pagination) loadWithCount(out interface{}) (*Pagination, error) {
+	countModel := p.Model.Session(&gorm.Session{})
+	findModel := p.Model.Session(&gorm.Session{}).Scopes(Scope(p.GetPage(), p.Size))
+
+	var total int64
+	var countErr, findErr error
+	done := make(chan struct{}, 2)
+
+	go func() {
+		countErr = countModel.Count(&total).Error
+		done <- struct{}{}
+	}()
+	go func() {
+		findErr = findModel.Find(out).Error
+		done <- struct{}{}
+	}()
+	<-done
+	<-done
+
+	if countErr != nil {
+		return p, countErr
+	}
+	if findErr != nil {
+		p.Error = ptr.String("unable to load data from the database")
+		return p, findErr
+	}
+
+	p.Model = findModel
+	p.Records = int(total)
+	p.setPages()
+	p.buildLinks()
+
+	p.Success = true
+	p.Data = out
+	return p, nil
+}
+
+// loadWithoutCount fetches Size+1 rows and trims the lookahead row off,
+// filling HasMore instead of running a COUNT(*).
+func (p *Pagination) loadWithoutCount(out interface{}) (*Pagination, error) {
+	findModel := p.Model.Limit(p.Size + 1).Offset(p.GetOffset())
+	if err := findModel.Find(out).Error; err != nil {
+		p.Error = ptr.String("unable to load data from the database")
+		return p, err
+	}
+
+	rows := reflect.ValueOf(out).Elem()
+	p.HasMore = rows.Len() > p.Size
+	if p.HasMore {
+		rows.Set(rows.Slice(0, p.Size))
+	}
+
+	p.Model = findModel
+	p.Success = true
+	p.Data = out
+	return p, nil
+}
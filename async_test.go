@@ -0,0 +1,72 @@
+package pagination
+
+import (
+	"testing"
+
+	"github.com/glebarez/sqlite"
+	"gorm.io/gorm"
+)
+
+type asyncTestRow struct {
+	ID     uint
+	Status string
+}
+
+func openAsyncTestDB(t *testing.T) *gorm.DB {
+	t.Helper()
+	db, err := gorm.Open(sqlite.Open(":memory:"), &gorm.Config{})
+	if err != nil {
+		t.Fatalf("open db: %v", err)
+	}
+	if err := db.AutoMigrate(&asyncTestRow{}); err != nil {
+		t.Fatalf("migrate: %v", err)
+	}
+	for i := 0; i < 25; i++ {
+		if err := db.Create(&asyncTestRow{Status: "active"}).Error; err != nil {
+			t.Fatalf("seed: %v", err)
+		}
+	}
+	return db
+}
+
+// TestLoadWithCountRace exercises loadWithCount under -race with a model
+// that already has a Where clause chained onto it (as applyFilter/applySort
+// would do), so the count and find goroutines share the same starting
+// *gorm.DB and must each get their own clone of its Statement.
+func TestLoadWithCountRace(t *testing.T) {
+	db := openAsyncTestDB(t)
+
+	model := db.Model(&asyncTestRow{}).Where("status = ?", "active")
+	p := &Pagination{Model: model, Size: 10, countTotal: true}
+	p.setCurrentPage(1)
+
+	var rows []asyncTestRow
+	if _, err := p.loadWithCount(&rows); err != nil {
+		t.Fatalf("loadWithCount: %v", err)
+	}
+	if p.Records != 25 {
+		t.Fatalf("expected 25 records, got %d", p.Records)
+	}
+	if len(rows) != 10 {
+		t.Fatalf("expected 10 rows, got %d", len(rows))
+	}
+}
+
+func TestLoadWithoutCountHasMore(t *testing.T) {
+	db := openAsyncTestDB(t)
+
+	model := db.Model(&asyncTestRow{}).Where("status = ?", "active")
+	p := &Pagination{Model: model, Size: 10, countTotal: false}
+	p.setCurrentPage(1)
+
+	var rows []asyncTestRow
+	if _, err := p.loadWithoutCount(&rows); err != nil {
+		t.Fatalf("loadWithoutCount: %v", err)
+	}
+	if !p.HasMore {
+		t.Fatalf("expected HasMore to be true with 25 rows and size 10")
+	}
+	if len(rows) != 10 {
+		t.Fatalf("expected 10 rows, got %d", len(rows))
+	}
+}
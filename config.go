@@ -0,0 +1,36 @@
+package pagination
+
+// Config controls the defaults and query parameter names New, NewCursor
+// and NewWithConfig use. Overriding it lets an API adopt its own naming
+// (e.g. "per_page"/"page_size") without forking the package.
+type Config struct {
+	DefaultSize int    // page size used when SizeParam is absent
+	MinSize     int    // smallest page size a caller may request
+	MaxSize     int    // largest page size a caller may request
+	PageParam   string // query parameter holding the page number
+	SizeParam   string // query parameter holding the page size
+	SortParam   string // query parameter holding the sort spec
+	CursorParam string // query parameter holding the opaque cursor token
+	CountTotal  bool   // whether offset mode runs COUNT(*) to fill Records/Pages
+}
+
+// defaultConfig is the package-wide Config used by New, NewCursor and
+// every Pagination that doesn't go through NewWithConfig. Override it
+// with SetDefaults.
+var defaultConfig = Config{
+	DefaultSize: 10,
+	MinSize:     10,
+	MaxSize:     50,
+	PageParam:   "page",
+	SizeParam:   "limit",
+	SortParam:   "sort",
+	CursorParam: "cursor",
+	CountTotal:  true,
+}
+
+// SetDefaults overrides the package-level Config used by New, NewCursor
+// and any Pagination not built via NewWithConfig. It is typically called
+// once at startup.
+func SetDefaults(cfg Config) {
+	defaultConfig = cfg
+}
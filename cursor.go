@@ -0,0 +1,235 @@
+package pagination
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"strings"
+
+	"github.com/getevo/evo/v2"
+	"github.com/getevo/evo/v2/lib/ptr"
+	"gorm.io/gorm"
+)
+
+// CursorColumn is one column of a keyset cursor's ordering key, applied in
+// the given order to both ORDER BY and the WHERE tuple comparison. Columns
+// are compiled from the strings passed to NewCursor: a leading "-" marks a
+// column as descending, e.g. "-created_at".
+type CursorColumn struct {
+	Name string
+	Desc bool
+}
+
+// cursorState is the opaque payload encoded into the "cursor" query
+// parameter. It carries the key values of the row paging should continue
+// from, plus the direction of travel.
+type cursorState struct {
+	Values   []interface{} `json:"v"`
+	Backward bool          `json:"b"`
+}
+
+// NewCursor builds a Pagination in keyset (cursor) mode: it orders the
+// query by columns, reads the "cursor" and "limit" query parameters off
+// request, and applies a WHERE tuple comparison against the cursor's row
+// instead of a COUNT(*) + LIMIT/OFFSET. This avoids the count query
+// entirely and keeps paging O(log n) on indexed columns, at the cost of
+// the random-access "jump to page N" offset mode gives you.
+func NewCursor(model *gorm.DB, request *evo.Request, columns ...string) (*Pagination, error) {
+	if len(columns) == 0 {
+		return nil, fmt.Errorf("pagination: NewCursor requires at least one cursor column")
+	}
+
+	var p = Pagination{Model: model}
+	p.cursorColumns = make([]CursorColumn, len(columns))
+	for i, col := range columns {
+		if strings.HasPrefix(col, "-") {
+			p.cursorColumns[i] = CursorColumn{Name: col[1:], Desc: true}
+		} else {
+			p.cursorColumns[i] = CursorColumn{Name: col}
+		}
+	}
+
+	p.MaxSize = defaultConfig.MaxSize
+	p.Size = request.Query(defaultConfig.SizeParam).Int()
+	if p.Size <= 0 {
+		p.Size = defaultConfig.DefaultSize
+	} else if p.Size < defaultConfig.MinSize {
+		p.Size = defaultConfig.MinSize
+	}
+	if p.Size > p.MaxSize {
+		p.Size = p.MaxSize
+	}
+
+	if raw := request.Query(defaultConfig.CursorParam).String(); raw != "" {
+		state, err := decodeCursor(raw)
+		if err != nil {
+			p.Error = ptr.String("invalid cursor")
+			return &p, err
+		}
+		if len(state.Values) != len(p.cursorColumns) {
+			err = fmt.Errorf("pagination: cursor has %d values, expected %d", len(state.Values), len(p.cursorColumns))
+			p.Error = ptr.String("invalid cursor")
+			return &p, err
+		}
+		p.cursorValues = state.Values
+		p.cursorBackward = state.Backward
+		where, args := cursorWhere(p.cursorColumns, state.Values, state.Backward)
+		p.Model = p.Model.Where(where, args...)
+	}
+
+	p.Model = p.Model.Order(cursorOrder(p.cursorColumns, p.cursorBackward))
+
+	return &p, nil
+}
+
+// loadCursorData fetches one page worth of rows (plus a lookahead row to
+// detect whether another page follows) and fills NextCursor/PrevCursor.
+func (p *Pagination) loadCursorData(out interface{}) (*Pagination, error) {
+	db := p.Model.Limit(p.Size + 1)
+	if err := db.Find(out).Error; err != nil {
+		p.Error = ptr.String("unable to load data from the database")
+		return p, err
+	}
+
+	rows := reflect.ValueOf(out).Elem()
+	hasMore := rows.Len() > p.Size
+	if hasMore {
+		rows.Set(rows.Slice(0, p.Size))
+	}
+	if p.cursorBackward {
+		reverseSlice(rows)
+	}
+
+	if rows.Len() > 0 {
+		first := p.cursorRowValues(db, rows.Index(0))
+		last := p.cursorRowValues(db, rows.Index(rows.Len()-1))
+
+		if p.cursorBackward {
+			if hasMore {
+				if cursor, err := encodeCursor(first, true); err == nil {
+					p.PrevCursor = &cursor
+				}
+			}
+			if cursor, err := encodeCursor(last, false); err == nil {
+				p.NextCursor = &cursor
+			}
+		} else {
+			if hasMore {
+				if cursor, err := encodeCursor(last, false); err == nil {
+					p.NextCursor = &cursor
+				}
+			}
+			if len(p.cursorValues) > 0 {
+				if cursor, err := encodeCursor(first, true); err == nil {
+					p.PrevCursor = &cursor
+				}
+			}
+		}
+	}
+
+	p.Success = true
+	p.Data = out
+	return p, nil
+}
+
+// cursorRowValues reads the cursor columns off a single row using the
+// resolved GORM schema, in the same order as p.cursorColumns.
+func (p *Pagination) cursorRowValues(db *gorm.DB, row reflect.Value) []interface{} {
+	values := make([]interface{}, len(p.cursorColumns))
+	for i, col := range p.cursorColumns {
+		field := db.Statement.Schema.LookUpField(col.Name)
+		if field == nil {
+			continue
+		}
+		value, _ := field.ValueOf(db.Statement.Context, row)
+		values[i] = value
+	}
+	return values
+}
+
+// reverseSlice reverses s in place; s must be addressable (e.g. the Elem
+// of a pointer to a slice).
+func reverseSlice(s reflect.Value) {
+	for i, j := 0, s.Len()-1; i < j; i, j = i+1, j-1 {
+		tmp := reflect.New(s.Index(i).Type()).Elem()
+		tmp.Set(s.Index(i))
+		s.Index(i).Set(s.Index(j))
+		s.Index(j).Set(tmp)
+	}
+}
+
+// cursorWhere expands a keyset tuple comparison into the equivalent
+// OR-of-ANDs clause, e.g. for columns (a, b): "(a > ?) OR (a = ? AND b > ?)".
+func cursorWhere(columns []CursorColumn, values []interface{}, backward bool) (string, []interface{}) {
+	var clauses []string
+	var args []interface{}
+	for i := range columns {
+		var parts []string
+		for j := 0; j < i; j++ {
+			parts = append(parts, fmt.Sprintf("%s = ?", columns[j].Name))
+			args = append(args, values[j])
+		}
+		parts = append(parts, fmt.Sprintf("%s %s ?", columns[i].Name, cursorOp(columns[i], backward)))
+		args = append(args, values[i])
+		clauses = append(clauses, "("+strings.Join(parts, " AND ")+")")
+	}
+	return strings.Join(clauses, " OR "), args
+}
+
+// cursorOp returns the comparison operator for a column, accounting for
+// its sort direction and whether we're paging backward.
+func cursorOp(col CursorColumn, backward bool) string {
+	op := ">"
+	if col.Desc {
+		op = "<"
+	}
+	if backward {
+		if op == ">" {
+			return "<"
+		}
+		return ">"
+	}
+	return op
+}
+
+// cursorOrder builds the ORDER BY clause for columns, flipping every
+// direction when paging backward so the lookahead query still walks
+// toward the cursor; loadCursorData reverses the fetched rows afterwards.
+func cursorOrder(columns []CursorColumn, backward bool) string {
+	parts := make([]string, len(columns))
+	for i, col := range columns {
+		asc := !col.Desc
+		if backward {
+			asc = !asc
+		}
+		if asc {
+			parts[i] = col.Name + " ASC"
+		} else {
+			parts[i] = col.Name + " DESC"
+		}
+	}
+	return strings.Join(parts, ", ")
+}
+
+// encodeCursor serializes a row's key values into the opaque cursor token.
+func encodeCursor(values []interface{}, backward bool) (string, error) {
+	data, err := json.Marshal(cursorState{Values: values, Backward: backward})
+	if err != nil {
+		return "", err
+	}
+	return base64.URLEncoding.EncodeToString(data), nil
+}
+
+// decodeCursor parses the opaque cursor token back into its row key values.
+func decodeCursor(raw string) (*cursorState, error) {
+	data, err := base64.URLEncoding.DecodeString(raw)
+	if err != nil {
+		return nil, err
+	}
+	var state cursorState
+	if err := json.Unmarshal(data, &state); err != nil {
+		return nil, err
+	}
+	return &state, nil
+}
@@ -0,0 +1,143 @@
+package pagination
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+
+	"github.com/getevo/evo/v2/lib/ptr"
+)
+
+// Op is a comparison operator that a filter[field] query parameter may use.
+type Op string
+
+const (
+	OpEQ      Op = "eq"
+	OpNEQ     Op = "neq"
+	OpGT      Op = "gt"
+	OpGTE     Op = "gte"
+	OpLT      Op = "lt"
+	OpLTE     Op = "lte"
+	OpIn      Op = "in"
+	OpLike    Op = "like"
+	OpBetween Op = "between"
+	OpIsNull  Op = "is_null"
+)
+
+var filterSQL = map[Op]string{
+	OpEQ:   "%s = ?",
+	OpNEQ:  "%s <> ?",
+	OpGT:   "%s > ?",
+	OpGTE:  "%s >= ?",
+	OpLT:   "%s < ?",
+	OpLTE:  "%s <= ?",
+	OpIn:   "%s IN ?",
+	OpLike: "%s LIKE ?",
+}
+
+// Filter is one applied filter[field] condition, echoed back in the
+// Filters field of the JSON response.
+type Filter struct {
+	Field string      `json:"field"`
+	Op    Op          `json:"op"`
+	Value interface{} `json:"value"`
+}
+
+var filterKeyPattern = regexp.MustCompile(`^filter\[([^\]]+)\](?:\[([^\]]+)\])?$`)
+
+// AllowFilter whitelists field for filtering via "filter[field]" (implicit
+// eq) and "filter[field][op]" query parameters, restricted to ops. Callers
+// must whitelist both the field and the operators it accepts; anything
+// else parsed out of the query string is ignored. Call AllowFilter before
+// LoadData.
+func (p *Pagination) AllowFilter(field string, ops ...Op) *Pagination {
+	if p.allowedFilter == nil {
+		p.allowedFilter = make(map[string]map[Op]bool)
+	}
+	if p.allowedFilter[field] == nil {
+		p.allowedFilter[field] = make(map[Op]bool, len(ops))
+	}
+	for _, op := range ops {
+		p.allowedFilter[field][op] = true
+	}
+	return p
+}
+
+// parseFilter reads filter[field] / filter[field][op] query parameters,
+// keeping only whitelisted field/op combinations. A whitelisted field with
+// a malformed value (e.g. a "between" value without two comma-separated
+// parts) sets p.Error;
+// LoadData checks this and aborts the load instead of running the query
+// with that filter silently dropped.
+func (p *Pagination) parseFilter() {
+	if len(p.allowedFilter) == 0 {
+		return
+	}
+
+	for key, raw := range p.request.Context.Queries() {
+		match := filterKeyPattern.FindStringSubmatch(key)
+		if match == nil {
+			continue
+		}
+		field := match[1]
+		op := Op(match[2])
+		if op == "" {
+			op = OpEQ
+		}
+
+		if !p.allowedFilter[field][op] {
+			continue
+		}
+
+		value, err := parseFilterValue(op, raw)
+		if err != nil {
+			p.Error = ptr.String(fmt.Sprintf("invalid filter value for %s[%s]: %s", field, op, raw))
+			continue
+		}
+
+		p.Filters = append(p.Filters, Filter{Field: field, Op: op, Value: value})
+	}
+}
+
+// parseFilterValue converts a raw query string into the value GORM
+// expects for op.
+func parseFilterValue(op Op, raw string) (interface{}, error) {
+	switch op {
+	case OpIn:
+		parts := strings.Split(raw, ",")
+		values := make([]interface{}, len(parts))
+		for i, part := range parts {
+			values[i] = part
+		}
+		return values, nil
+	case OpBetween:
+		parts := strings.SplitN(raw, ",", 2)
+		if len(parts) != 2 {
+			return nil, fmt.Errorf("between requires two comma-separated values")
+		}
+		return []interface{}{parts[0], parts[1]}, nil
+	case OpIsNull:
+		return raw != "false" && raw != "0", nil
+	default:
+		return raw, nil
+	}
+}
+
+// applyFilter applies the parsed Filters to p.Model via Where(...).
+func (p *Pagination) applyFilter() {
+	for _, filter := range p.Filters {
+		switch filter.Op {
+		case OpBetween:
+			bounds := filter.Value.([]interface{})
+			p.Model = p.Model.Where(fmt.Sprintf("%s BETWEEN ? AND ?", filter.Field), bounds[0], bounds[1])
+		case OpIsNull:
+			if filter.Value.(bool) {
+				p.Model = p.Model.Where(fmt.Sprintf("%s IS NULL", filter.Field))
+			} else {
+				p.Model = p.Model.Where(fmt.Sprintf("%s IS NOT NULL", filter.Field))
+			}
+		default:
+			p.Model = p.Model.Where(fmt.Sprintf(filterSQL[filter.Op], filter.Field), filter.Value)
+		}
+	}
+}
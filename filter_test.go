@@ -0,0 +1,102 @@
+package pagination
+
+import (
+	"net/http/httptest"
+	"testing"
+
+	"github.com/getevo/evo/v2"
+	"github.com/gofiber/fiber/v3"
+)
+
+// TestParseFilterAllowlist checks that only whitelisted field/op
+// combinations survive parsing, and that everything else in the query
+// string (an unlisted field, an unlisted op on a listed field) is
+// dropped rather than forwarded into a WHERE clause.
+func TestParseFilterAllowlist(t *testing.T) {
+	db := openAsyncTestDB(t)
+
+	app := fiber.New()
+	var got *Pagination
+	app.Get("/", func(ctx fiber.Ctx) error {
+		request := evo.Upgrade(ctx)
+		p := &Pagination{Model: db.Model(&asyncTestRow{}), request: request}
+		p.AllowFilter("status", OpEQ, OpIn)
+		p.parseFilter()
+		got = p
+		return nil
+	})
+
+	httpReq := httptest.NewRequest(
+		"GET",
+		"/?filter[status]=active&filter[status][gt]=active&filter[id]=1",
+		nil,
+	)
+	if _, err := app.Test(httpReq); err != nil {
+		t.Fatalf("app.Test: %v", err)
+	}
+
+	if len(got.Filters) != 1 {
+		t.Fatalf("expected exactly one whitelisted filter, got %+v", got.Filters)
+	}
+	f := got.Filters[0]
+	if f.Field != "status" || f.Op != OpEQ || f.Value != "active" {
+		t.Fatalf("unexpected filter: %+v", f)
+	}
+}
+
+func TestParseFilterValueBetweenAndIsNull(t *testing.T) {
+	between, err := parseFilterValue(OpBetween, "1,10")
+	if err != nil {
+		t.Fatalf("parseFilterValue between: %v", err)
+	}
+	bounds := between.([]interface{})
+	if bounds[0] != "1" || bounds[1] != "10" {
+		t.Fatalf("unexpected between bounds: %v", bounds)
+	}
+
+	if _, err := parseFilterValue(OpBetween, "only-one"); err == nil {
+		t.Fatalf("expected an error for a between value without two parts")
+	}
+
+	isNull, err := parseFilterValue(OpIsNull, "true")
+	if err != nil || isNull != true {
+		t.Fatalf("parseFilterValue is_null(true) = %v, %v", isNull, err)
+	}
+	isNull, err = parseFilterValue(OpIsNull, "false")
+	if err != nil || isNull != false {
+		t.Fatalf("parseFilterValue is_null(false) = %v, %v", isNull, err)
+	}
+}
+
+// TestLoadDataAbortsOnFilterError checks that a malformed value on a
+// whitelisted filter makes LoadData return an error and leave p.Success
+// false, instead of running the query with that filter silently dropped.
+func TestLoadDataAbortsOnFilterError(t *testing.T) {
+	db := openAsyncTestDB(t)
+
+	app := fiber.New()
+	var got *Pagination
+	var gotErr error
+	app.Get("/", func(ctx fiber.Ctx) error {
+		request := evo.Upgrade(ctx)
+		p := &Pagination{Model: db.Model(&asyncTestRow{}), request: request, Size: 10}
+		p.AllowFilter("id", OpBetween)
+		got, gotErr = p.LoadData(&[]asyncTestRow{})
+		return nil
+	})
+
+	httpReq := httptest.NewRequest("GET", "/?filter[id][between]=only-one-value", nil)
+	if _, err := app.Test(httpReq); err != nil {
+		t.Fatalf("app.Test: %v", err)
+	}
+
+	if gotErr == nil {
+		t.Fatalf("expected LoadData to return an error for a malformed filter value")
+	}
+	if got.Success {
+		t.Fatalf("expected p.Success to stay false when a filter failed to parse")
+	}
+	if got.Error == nil {
+		t.Fatalf("expected p.Error to be set")
+	}
+}
@@ -0,0 +1,79 @@
+package pagination
+
+import (
+	"fmt"
+	"net/url"
+	"strings"
+
+	"github.com/getevo/evo/v2"
+	"github.com/getevo/evo/v2/lib/outcome"
+)
+
+// Links holds HATEOAS-style navigation URLs for the current offset page,
+// computed by replacing the page query parameter on the original request
+// URL. Prev/Next are only set when a previous/next page actually exists.
+type Links struct {
+	First string `json:"first,omitempty"`
+	Prev  string `json:"prev,omitempty"`
+	Next  string `json:"next,omitempty"`
+	Last  string `json:"last,omitempty"`
+}
+
+// buildLinks fills p.Links from the incoming request's URL. It only
+// applies to offset mode, where CurrentPage/Pages are known.
+func (p *Pagination) buildLinks() {
+	if p.request == nil {
+		return
+	}
+
+	p.Links = &Links{
+		First: p.pageURL(1),
+		Last:  p.pageURL(p.Pages),
+	}
+	if p.CurrentPage > 1 {
+		p.Links.Prev = p.pageURL(p.CurrentPage - 1)
+	}
+	if p.CurrentPage < p.Pages {
+		p.Links.Next = p.pageURL(p.CurrentPage + 1)
+	}
+}
+
+// pageURL returns the current request URL with its page parameter set to page.
+func (p *Pagination) pageURL(page int) string {
+	base := p.request.URL()
+
+	query := make(url.Values, len(base.Query))
+	for key, values := range base.Query {
+		query[key] = append([]string(nil), values...)
+	}
+
+	u := evo.URL{Path: base.Path, Query: query}
+	return u.Set(p.pageParam, page).String()
+}
+
+// GetResponseWithLinks builds the same JSON response as GetResponse, but
+// also sets an RFC 5988 Link header (rel="first", "prev", "next", "last")
+// from p.Links so the response is self-navigable without the client
+// having to parse the body.
+func (p *Pagination) GetResponseWithLinks() outcome.Response {
+	response := p.GetResponse()
+	if p.Links == nil {
+		return response
+	}
+
+	var rels []string
+	add := func(rel, href string) {
+		if href != "" {
+			rels = append(rels, fmt.Sprintf(`<%s>; rel="%s"`, href, rel))
+		}
+	}
+	add("first", p.Links.First)
+	add("prev", p.Links.Prev)
+	add("next", p.Links.Next)
+	add("last", p.Links.Last)
+
+	if len(rels) > 0 {
+		response.Header("Link", strings.Join(rels, ", "))
+	}
+	return response
+}
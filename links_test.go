@@ -0,0 +1,69 @@
+package pagination
+
+import (
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/getevo/evo/v2"
+	"github.com/gofiber/fiber/v3"
+)
+
+// TestBuildLinksOmitsPrevAndNextAtBoundaries checks that Prev is omitted
+// on the first page and Next is omitted on the last page, while First
+// and Last are always set.
+func TestBuildLinksOmitsPrevAndNextAtBoundaries(t *testing.T) {
+	app := fiber.New()
+	var first, last *Pagination
+	app.Get("/", func(ctx fiber.Ctx) error {
+		request := evo.Upgrade(ctx)
+
+		first = &Pagination{request: request, pageParam: "page", CurrentPage: 1, Pages: 3}
+		first.buildLinks()
+
+		last = &Pagination{request: request, pageParam: "page", CurrentPage: 3, Pages: 3}
+		last.buildLinks()
+		return nil
+	})
+
+	httpReq := httptest.NewRequest("GET", "/?page=1", nil)
+	if _, err := app.Test(httpReq); err != nil {
+		t.Fatalf("app.Test: %v", err)
+	}
+
+	if first.Links.Prev != "" {
+		t.Fatalf("expected no Prev link on the first page, got %q", first.Links.Prev)
+	}
+	if first.Links.Next == "" || first.Links.First == "" || first.Links.Last == "" {
+		t.Fatalf("expected First/Next/Last links on the first page, got %+v", first.Links)
+	}
+
+	if last.Links.Next != "" {
+		t.Fatalf("expected no Next link on the last page, got %q", last.Links.Next)
+	}
+	if last.Links.Prev == "" {
+		t.Fatalf("expected a Prev link on the last page, got %+v", last.Links)
+	}
+}
+
+// TestGetResponseWithLinksSetsLinkHeader checks that the RFC 5988 Link
+// header is built from p.Links and omits rels whose URL is empty.
+func TestGetResponseWithLinksSetsLinkHeader(t *testing.T) {
+	p := &Pagination{
+		Success: true,
+		Links:   &Links{First: "/?page=1", Next: "/?page=2", Last: "/?page=5"},
+	}
+
+	response := p.GetResponseWithLinks()
+
+	header := response.Headers["Link"]
+	if !strings.Contains(header, `rel="first"`) {
+		t.Fatalf("expected a first rel in the Link header, got %q", header)
+	}
+	if strings.Contains(header, `rel="prev"`) {
+		t.Fatalf("did not expect a prev rel when Links.Prev is empty, got %q", header)
+	}
+	if !strings.Contains(header, `rel="next"`) || !strings.Contains(header, `rel="last"`) {
+		t.Fatalf("expected next and last rels in the Link header, got %q", header)
+	}
+}
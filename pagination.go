@@ -1,9 +1,10 @@
 package pagination
 
 import (
+	"errors"
+
 	"github.com/getevo/evo/v2"
 	"github.com/getevo/evo/v2/lib/outcome"
-	"github.com/getevo/evo/v2/lib/ptr"
 	"gorm.io/gorm"
 )
 
@@ -18,6 +19,10 @@ import (
 // - Last: Last page.
 // - PageRange: Range of visible pages.
 //
+// In cursor (keyset) mode, built via NewCursor, Records/Pages/CurrentPage/First/Last
+// are not meaningful and are omitted from the JSON response; NextCursor/PrevCursor
+// are populated instead. See cursor.go.
+//
 // Methods:
 // - SetCurrentPage: Sets the current page based on the provided value. If the value is 0, the current page is set to 1.
 // - SetLimit: Sets the limit of rows per page. If the value is 0, the limit is set to the minimum limit of 10. If the limit is less than the minimum limit, it is set to the minimum
@@ -26,14 +31,33 @@ type Pagination struct {
 	Executed    bool        `json:"-"`
 	Success     bool        `json:"success"`
 	Error       *string     `json:"error,omitempty"`
-	Records     int         `json:"records"`      // Total rows
-	CurrentPage int         `json:"current_page"` // Current Page loaded
-	Pages       int         `json:"pages"`        // total number of pages
-	Size        int         `json:"size"`         // number of rows per page
+	Records     int         `json:"records,omitempty"`      // Total rows
+	CurrentPage int         `json:"current_page,omitempty"` // Current Page loaded
+	Pages       int         `json:"pages,omitempty"`        // total number of pages
+	Size        int         `json:"size"`                   // number of rows per page
 	MaxSize     int         `json:"max_size"`
-	First       int         `json:"first"` // First Page
-	Last        int         `json:"last"`  // Last Page
+	First       int         `json:"first,omitempty"` // First Page
+	Last        int         `json:"last,omitempty"`  // Last Page
+	NextCursor  *string     `json:"next_cursor,omitempty"`
+	PrevCursor  *string     `json:"prev_cursor,omitempty"`
+	Sort        []SortField `json:"sort,omitempty"`
+	Filters     []Filter    `json:"filters,omitempty"`
+	Links       *Links      `json:"links,omitempty"`
+	HasMore     bool        `json:"has_more,omitempty"`
 	Data        interface{} `json:"data"`
+
+	request   *evo.Request
+	pageParam string
+	sortParam string
+
+	cursorColumns  []CursorColumn
+	cursorValues   []interface{}
+	cursorBackward bool
+
+	allowedSort   map[string]bool
+	allowedFilter map[string]map[Op]bool
+
+	countTotal bool
 }
 
 // SetCurrentPage sets the value of CurrentPage in the Pagination struct.
@@ -105,23 +129,33 @@ func (p *Pagination) GetPage() int {
 	return p.CurrentPage
 }
 
+// New builds an offset-paginated Pagination using the package-wide
+// defaultConfig. Use NewWithConfig to override query parameter names or
+// size limits for a single call, or SetDefaults to change them globally.
 func New(model *gorm.DB, request *evo.Request, out ...interface{}) (*Pagination, error) {
+	return NewWithConfig(defaultConfig, model, request, out...)
+}
+
+// NewWithConfig builds an offset-paginated Pagination the same way New
+// does, but reads its query parameter names and size limits from cfg
+// instead of the package-wide defaultConfig.
+func NewWithConfig(cfg Config, model *gorm.DB, request *evo.Request, out ...interface{}) (*Pagination, error) {
 	var err error
-	var p = Pagination{}
-	var limit = request.Query("limit").Int()
-	var page = request.Query("page").Int()
-	if limit < 10 {
-		limit = 10
+	var p = Pagination{Model: model, request: request, MaxSize: cfg.MaxSize, countTotal: cfg.CountTotal, pageParam: cfg.PageParam, sortParam: cfg.SortParam}
+	var limit = request.Query(cfg.SizeParam).Int()
+	var page = request.Query(cfg.PageParam).Int()
+	if limit <= 0 {
+		limit = cfg.DefaultSize
+	} else if limit < cfg.MinSize {
+		limit = cfg.MinSize
 	}
-	if limit > p.MaxSize {
-		if p.MaxSize == 0 {
-			p.MaxSize = 50
-		}
-		limit = p.MaxSize
+	if limit > cfg.MaxSize {
+		limit = cfg.MaxSize
 	}
 	if page < 1 {
 		page = 1
 	}
+	p.Size = limit
 	p.setCurrentPage(page)
 
 	if len(out) > 0 {
@@ -132,24 +166,24 @@ func New(model *gorm.DB, request *evo.Request, out ...interface{}) (*Pagination,
 }
 
 func (p *Pagination) LoadData(out interface{}) (*Pagination, error) {
-	var total int64
-	if err := p.Model.Count(&total).Error; err != nil {
-		return p, err
+	if p.cursorColumns != nil {
+		return p.loadCursorData(out)
 	}
-	p.Records = int(total)
-	p.setPages()
-
-	p.Model = p.Model.Limit(p.Size)
-	p.Model = p.Model.Offset(p.GetOffset())
-	if err := p.Model.Find(out).Error; err != nil {
-		if err != nil {
-			p.Error = ptr.String("unable to load data from the database")
+
+	if p.request != nil {
+		p.parseSort(p.request)
+		p.applySort()
+		p.parseFilter()
+		if p.Error != nil {
+			return p, errors.New(*p.Error)
 		}
-		return p, err
+		p.applyFilter()
+	}
+
+	if p.countTotal {
+		return p.loadWithCount(out)
 	}
-	p.Success = true
-	p.Data = out
-	return p, nil
+	return p.loadWithoutCount(out)
 }
 
 func (p *Pagination) GetResponse() outcome.Response {
@@ -0,0 +1,88 @@
+package pagination
+
+import (
+	"net/http/httptest"
+	"testing"
+
+	"github.com/getevo/evo/v2"
+	"github.com/gofiber/fiber/v3"
+)
+
+// TestNewWithConfigClampsSize checks the limit <= 0 -> DefaultSize,
+// limit < MinSize -> MinSize, and limit > MaxSize -> MaxSize clamping
+// NewWithConfig applies to the "limit" query parameter.
+func TestNewWithConfigClampsSize(t *testing.T) {
+	db := openAsyncTestDB(t)
+
+	cfg := Config{DefaultSize: 10, MinSize: 5, MaxSize: 20, PageParam: "page", SizeParam: "limit", SortParam: "sort", CursorParam: "cursor"}
+
+	cases := []struct {
+		name  string
+		query string
+		want  int
+	}{
+		{"absent", "/", cfg.DefaultSize},
+		{"zero", "/?limit=0", cfg.DefaultSize},
+		{"below min", "/?limit=2", cfg.MinSize},
+		{"above max", "/?limit=100", cfg.MaxSize},
+		{"in range", "/?limit=12", 12},
+	}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			app := fiber.New()
+			var got *Pagination
+			app.Get("/", func(ctx fiber.Ctx) error {
+				request := evo.Upgrade(ctx)
+				p, err := NewWithConfig(cfg, db.Model(&asyncTestRow{}), request)
+				if err != nil {
+					t.Fatalf("NewWithConfig: %v", err)
+				}
+				got = p
+				return nil
+			})
+
+			httpReq := httptest.NewRequest("GET", c.query, nil)
+			if _, err := app.Test(httpReq); err != nil {
+				t.Fatalf("app.Test: %v", err)
+			}
+			if got.Size != c.want {
+				t.Fatalf("Size = %d, want %d", got.Size, c.want)
+			}
+		})
+	}
+}
+
+// TestSetDefaultsAffectsNew checks that New reads the package-wide
+// defaultConfig at call time, so SetDefaults changes what it sees.
+func TestSetDefaultsAffectsNew(t *testing.T) {
+	original := defaultConfig
+	defer func() { defaultConfig = original }()
+
+	SetDefaults(Config{DefaultSize: 7, MinSize: 7, MaxSize: 7, PageParam: "p", SizeParam: "l", SortParam: "s", CursorParam: "c"})
+
+	db := openAsyncTestDB(t)
+	app := fiber.New()
+	var got *Pagination
+	app.Get("/", func(ctx fiber.Ctx) error {
+		request := evo.Upgrade(ctx)
+		p, err := New(db.Model(&asyncTestRow{}), request)
+		if err != nil {
+			t.Fatalf("New: %v", err)
+		}
+		got = p
+		return nil
+	})
+
+	httpReq := httptest.NewRequest("GET", "/?p=2", nil)
+	if _, err := app.Test(httpReq); err != nil {
+		t.Fatalf("app.Test: %v", err)
+	}
+
+	if got.Size != 7 {
+		t.Fatalf("expected New to pick up the overridden DefaultSize, got Size = %d", got.Size)
+	}
+	if got.CurrentPage != 2 {
+		t.Fatalf("expected New to read the overridden PageParam \"p\", got CurrentPage = %d", got.CurrentPage)
+	}
+}
@@ -0,0 +1,44 @@
+package pagination
+
+import (
+	"github.com/getevo/evo/v2"
+	"gorm.io/gorm"
+)
+
+// Scope returns a GORM scope that applies the LIMIT/OFFSET for page and
+// size, composable via db.Scopes(...) inside transactions, subqueries and
+// preloads where the Pagination/LoadData API doesn't fit. page and size
+// are clamped to at least 1. LoadData uses this scope internally.
+func Scope(page, size int) func(*gorm.DB) *gorm.DB {
+	if page < 1 {
+		page = 1
+	}
+	if size < 1 {
+		size = 1
+	}
+	offset := (page - 1) * size
+
+	return func(db *gorm.DB) *gorm.DB {
+		return db.Offset(offset).Limit(size)
+	}
+}
+
+// ScopeFromRequest builds a Scope from request's page/size query
+// parameters, read the same way New does: PageParam/SizeParam from the
+// package-wide defaultConfig, with DefaultSize/MinSize/MaxSize applied.
+func ScopeFromRequest(request *evo.Request) func(*gorm.DB) *gorm.DB {
+	cfg := defaultConfig
+
+	page := request.Query(cfg.PageParam).Int()
+	size := request.Query(cfg.SizeParam).Int()
+	if size <= 0 {
+		size = cfg.DefaultSize
+	} else if size < cfg.MinSize {
+		size = cfg.MinSize
+	}
+	if size > cfg.MaxSize {
+		size = cfg.MaxSize
+	}
+
+	return Scope(page, size)
+}
@@ -0,0 +1,57 @@
+package pagination
+
+import (
+	"testing"
+
+	"github.com/getevo/evo/v2"
+	"github.com/gofiber/fiber/v3"
+	"net/http/httptest"
+)
+
+// TestScopeOffsetAndClamping checks the LIMIT/OFFSET math for a normal
+// page and the clamping of out-of-range page/size to 1.
+func TestScopeOffsetAndClamping(t *testing.T) {
+	db := openAsyncTestDB(t)
+
+	var rows []asyncTestRow
+	if err := db.Model(&asyncTestRow{}).Order("id ASC").Scopes(Scope(3, 5)).Find(&rows).Error; err != nil {
+		t.Fatalf("find: %v", err)
+	}
+	if len(rows) != 5 || rows[0].ID != 11 {
+		t.Fatalf("expected 5 rows starting at id 11, got %d rows starting at id %d", len(rows), rows[0].ID)
+	}
+
+	var clamped []asyncTestRow
+	if err := db.Model(&asyncTestRow{}).Order("id ASC").Scopes(Scope(0, 0)).Find(&clamped).Error; err != nil {
+		t.Fatalf("find: %v", err)
+	}
+	if len(clamped) != 1 || clamped[0].ID != 1 {
+		t.Fatalf("expected page/size 0 to clamp to page 1 size 1, got %d rows starting at id %d", len(clamped), clamped[0].ID)
+	}
+}
+
+// TestScopeFromRequestUsesDefaults checks that ScopeFromRequest falls
+// back to defaultConfig.DefaultSize when the size query param is absent.
+func TestScopeFromRequestUsesDefaults(t *testing.T) {
+	db := openAsyncTestDB(t)
+
+	app := fiber.New()
+	var rows []asyncTestRow
+	app.Get("/", func(ctx fiber.Ctx) error {
+		request := evo.Upgrade(ctx)
+		err := db.Model(&asyncTestRow{}).Order("id ASC").Scopes(ScopeFromRequest(request)).Find(&rows).Error
+		if err != nil {
+			t.Fatalf("find: %v", err)
+		}
+		return nil
+	})
+
+	httpReq := httptest.NewRequest("GET", "/?page=2", nil)
+	if _, err := app.Test(httpReq); err != nil {
+		t.Fatalf("app.Test: %v", err)
+	}
+
+	if len(rows) != defaultConfig.DefaultSize {
+		t.Fatalf("expected %d rows from the default size, got %d", defaultConfig.DefaultSize, len(rows))
+	}
+}
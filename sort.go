@@ -0,0 +1,68 @@
+package pagination
+
+import (
+	"strings"
+
+	"github.com/getevo/evo/v2"
+)
+
+// SortField is one column of an applied sort, echoed back in the Sort
+// field of the JSON response so clients can render sort indicators.
+type SortField struct {
+	Column string `json:"column"`
+	Desc   bool   `json:"desc"`
+}
+
+// AllowSort whitelists the columns that may be sorted via the "sort"
+// query parameter, e.g. "sort=-created_at,name" for created_at
+// descending then name ascending. Columns not on this list are silently
+// dropped instead of being forwarded into an ORDER BY clause, which
+// would otherwise let a caller inject arbitrary column names through
+// user input. Call AllowSort before LoadData.
+func (p *Pagination) AllowSort(cols ...string) *Pagination {
+	if p.allowedSort == nil {
+		p.allowedSort = make(map[string]bool, len(cols))
+	}
+	for _, col := range cols {
+		p.allowedSort[col] = true
+	}
+	return p
+}
+
+// parseSort reads the "sort" query parameter off request and keeps only
+// the columns whitelisted via AllowSort.
+func (p *Pagination) parseSort(request *evo.Request) {
+	raw := request.Query(p.sortParam).String()
+	if raw == "" {
+		return
+	}
+
+	for _, field := range strings.Split(raw, ",") {
+		field = strings.TrimSpace(field)
+		if field == "" {
+			continue
+		}
+
+		var desc bool
+		if strings.HasPrefix(field, "-") {
+			desc = true
+			field = field[1:]
+		}
+
+		if field == "" || !p.allowedSort[field] {
+			continue
+		}
+		p.Sort = append(p.Sort, SortField{Column: field, Desc: desc})
+	}
+}
+
+// applySort applies the parsed Sort fields to p.Model via Order(...).
+func (p *Pagination) applySort() {
+	for _, field := range p.Sort {
+		dir := "ASC"
+		if field.Desc {
+			dir = "DESC"
+		}
+		p.Model = p.Model.Order(field.Column + " " + dir)
+	}
+}
@@ -0,0 +1,74 @@
+package pagination
+
+import (
+	"net/http/httptest"
+	"testing"
+
+	"github.com/getevo/evo/v2"
+	"github.com/gofiber/fiber/v3"
+)
+
+// TestNewWithConfigHonorsSortParam makes sure a cfg.SortParam override
+// actually gets read, instead of NewWithConfig's resolved config being
+// silently ignored in favor of the package-wide defaultConfig.SortParam.
+func TestNewWithConfigHonorsSortParam(t *testing.T) {
+	db := openAsyncTestDB(t)
+
+	cfg := defaultConfig
+	cfg.SortParam = "order_by"
+
+	app := fiber.New()
+	var got *Pagination
+	app.Get("/", func(ctx fiber.Ctx) error {
+		request := evo.Upgrade(ctx)
+		p, err := NewWithConfig(cfg, db.Model(&asyncTestRow{}), request)
+		if err != nil {
+			t.Fatalf("NewWithConfig: %v", err)
+		}
+		p.AllowSort("status")
+		var rows []asyncTestRow
+		if _, err := p.LoadData(&rows); err != nil {
+			t.Fatalf("LoadData: %v", err)
+		}
+		got = p
+		return nil
+	})
+
+	httpReq := httptest.NewRequest("GET", "/?order_by=-status", nil)
+	if _, err := app.Test(httpReq); err != nil {
+		t.Fatalf("app.Test: %v", err)
+	}
+
+	if len(got.Sort) != 1 || got.Sort[0].Column != "status" || !got.Sort[0].Desc {
+		t.Fatalf("expected sort on status desc via the configured order_by param, got %+v", got.Sort)
+	}
+}
+
+// TestParseSortAllowlist checks that only whitelisted columns make it
+// into p.Sort, in whatever order and direction the "sort" query param
+// requested, and that an unlisted column is dropped instead of being
+// forwarded into an ORDER BY clause.
+func TestParseSortAllowlist(t *testing.T) {
+	app := fiber.New()
+	var got *Pagination
+	app.Get("/", func(ctx fiber.Ctx) error {
+		request := evo.Upgrade(ctx)
+		p := &Pagination{sortParam: "sort"}
+		p.AllowSort("name")
+		p.parseSort(request)
+		got = p
+		return nil
+	})
+
+	httpReq := httptest.NewRequest("GET", "/?sort=-secret,name", nil)
+	if _, err := app.Test(httpReq); err != nil {
+		t.Fatalf("app.Test: %v", err)
+	}
+
+	if len(got.Sort) != 1 {
+		t.Fatalf("expected the non-whitelisted \"secret\" column to be dropped, got %+v", got.Sort)
+	}
+	if got.Sort[0].Column != "name" || got.Sort[0].Desc {
+		t.Fatalf("unexpected sort field: %+v", got.Sort[0])
+	}
+}